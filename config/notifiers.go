@@ -272,6 +272,9 @@ type WebhookConfig struct {
 	// URL to send POST request to.
 	URL string `yaml:"url" json:"url"`
 
+	// HMACSecret, if set, is used to sign the request body with HMAC-SHA256.
+	HMACSecret Secret `yaml:"hmac_secret,omitempty" json:"hmac_secret,omitempty"`
+
 	// Catches all undefined fields and must be empty after parsing.
 	XXX map[string]interface{} `yaml:",inline" json:"-"`
 }
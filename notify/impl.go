@@ -15,8 +15,10 @@ package notify
 
 import (
 	"bytes"
+	"crypto/hmac"
 	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -28,6 +30,7 @@ import (
 	"net/mail"
 	"net/smtp"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -138,16 +141,35 @@ const contentTypeJSON = "application/json"
 
 var userAgentHeader = fmt.Sprintf("Alertmanager/%s", version.Version)
 
+const (
+	webhookTimestampHeader = "X-Alertmanager-Timestamp"
+	webhookSignatureHeader = "X-Alertmanager-Signature"
+)
+
 // Webhook implements a Notifier for generic webhooks.
 type Webhook struct {
 	// The URL to which notifications are sent.
-	URL  string
-	tmpl *template.Template
+	URL string
+	// The secret with which request bodies are signed. Empty disables signing.
+	HMACSecret config.Secret
+	tmpl       *template.Template
+	now        func() time.Time
 }
 
 // NewWebhook returns a new Webhook.
 func NewWebhook(conf *config.WebhookConfig, t *template.Template) *Webhook {
-	return &Webhook{URL: conf.URL, tmpl: t}
+	return &Webhook{URL: conf.URL, HMACSecret: conf.HMACSecret, tmpl: t, now: time.Now}
+}
+
+// webhookSignature returns the hex encoded HMAC-SHA256 of the timestamp and
+// the body joined by a dot. Including the timestamp allows receivers to
+// reject replayed requests.
+func webhookSignature(secret config.Secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
 }
 
 // WebhookMessage defines the JSON object send to webhook endpoints.
@@ -178,6 +200,7 @@ func (w *Webhook) Notify(ctx context.Context, alerts ...*types.Alert) (bool, err
 	if err := json.NewEncoder(&buf).Encode(msg); err != nil {
 		return false, err
 	}
+	body := buf.Bytes()
 
 	req, err := http.NewRequest("POST", w.URL, &buf)
 	if err != nil {
@@ -186,6 +209,12 @@ func (w *Webhook) Notify(ctx context.Context, alerts ...*types.Alert) (bool, err
 	req.Header.Set("Content-Type", contentTypeJSON)
 	req.Header.Set("User-Agent", userAgentHeader)
 
+	if w.HMACSecret != "" {
+		ts := strconv.FormatInt(w.now().Unix(), 10)
+		req.Header.Set(webhookTimestampHeader, ts)
+		req.Header.Set(webhookSignatureHeader, "sha256="+webhookSignature(w.HMACSecret, ts, body))
+	}
+
 	resp, err := ctxhttp.Do(ctx, http.DefaultClient, req)
 	if err != nil {
 		return true, err
@@ -0,0 +1,144 @@
+// Copyright 2017 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func webhookTestContext() context.Context {
+	ctx := WithReceiverName(context.Background(), "name")
+	ctx = WithGroupLabels(ctx, model.LabelSet{"alertname": "test"})
+	return WithGroupKey(ctx, "1")
+}
+
+func webhookTestAlerts() []*types.Alert {
+	return []*types.Alert{
+		{
+			Alert: model.Alert{
+				Labels:   model.LabelSet{"alertname": "test"},
+				StartsAt: time.Now(),
+			},
+		},
+	}
+}
+
+func TestWebhookSignature(t *testing.T) {
+	var (
+		body   []byte
+		header http.Header
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		body, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	tmpl, err := template.FromGlobs()
+	require.NoError(t, err)
+	tmpl.ExternalURL, _ = url.Parse("http://am")
+
+	conf := &config.WebhookConfig{URL: srv.URL, HMACSecret: "mysecret"}
+	w := NewWebhook(conf, tmpl)
+
+	retry, err := w.Notify(webhookTestContext(), webhookTestAlerts()...)
+	require.NoError(t, err)
+	require.False(t, retry)
+
+	ts := header.Get(webhookTimestampHeader)
+	require.NotEmpty(t, ts)
+
+	mac := hmac.New(sha256.New, []byte("mysecret"))
+	mac.Write([]byte(ts + "."))
+	mac.Write(body)
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	require.Equal(t, expected, header.Get(webhookSignatureHeader))
+}
+
+func TestWebhookSignatureTimestamp(t *testing.T) {
+	var (
+		bodies  [][]byte
+		headers []http.Header
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, body)
+		headers = append(headers, r.Header)
+	}))
+	defer srv.Close()
+
+	tmpl, err := template.FromGlobs()
+	require.NoError(t, err)
+	tmpl.ExternalURL, _ = url.Parse("http://am")
+
+	conf := &config.WebhookConfig{URL: srv.URL, HMACSecret: "mysecret"}
+	w := NewWebhook(conf, tmpl)
+	alerts := webhookTestAlerts()
+
+	for _, now := range []time.Time{time.Unix(1000, 0), time.Unix(1001, 0)} {
+		w.now = func() time.Time { return now }
+
+		_, err = w.Notify(webhookTestContext(), alerts...)
+		require.NoError(t, err)
+	}
+
+	require.Len(t, headers, 2)
+	require.Equal(t, "1000", headers[0].Get(webhookTimestampHeader))
+	require.Equal(t, "1001", headers[1].Get(webhookTimestampHeader))
+
+	// The same body must be signed differently at different times.
+	require.Equal(t, bodies[0], bodies[1])
+	for i, h := range headers {
+		expected := "sha256=" + webhookSignature("mysecret", h.Get(webhookTimestampHeader), bodies[i])
+		require.Equal(t, expected, h.Get(webhookSignatureHeader))
+	}
+	require.NotEqual(t, headers[0].Get(webhookSignatureHeader), headers[1].Get(webhookSignatureHeader))
+}
+
+func TestWebhookNoSignature(t *testing.T) {
+	var header http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+	}))
+	defer srv.Close()
+
+	tmpl, err := template.FromGlobs()
+	require.NoError(t, err)
+	tmpl.ExternalURL, _ = url.Parse("http://am")
+
+	w := NewWebhook(&config.WebhookConfig{URL: srv.URL}, tmpl)
+
+	_, err = w.Notify(webhookTestContext(), webhookTestAlerts()...)
+	require.NoError(t, err)
+
+	require.Empty(t, header.Get(webhookTimestampHeader))
+	require.Empty(t, header.Get(webhookSignatureHeader))
+}
@@ -167,6 +167,35 @@ func (a *Alerts) Get(fp model.Fingerprint) (*types.Alert, error) {
 	return alert, nil
 }
 
+// stale returns true if alert is outdated by old, the alert currently held
+// for the same fingerprint. Since UpdatedAt is set on receipt, it cannot tell
+// which of a firing and a resolved alert is newer. Instead the firing alert's
+// StartsAt is compared against the resolved alert's EndsAt, with the
+// resolution winning ties. This makes the result independent of the order
+// in which both are received, including within a single batch.
+func stale(alert, old *types.Alert) bool {
+	// A resolved alert that ended before the held alert started belongs
+	// to an earlier occurrence.
+	if alert.Resolved() && alert.EndsAt.Before(old.StartsAt) {
+		return true
+	}
+	// A firing alert that started before an explicit resolution is a late
+	// update of the resolved occurrence. Resolutions by timeout are not
+	// considered, as the alert may still be firing at its source.
+	if !alert.Resolved() && resolvedOnReceipt(old) && !old.Timeout {
+		return !alert.StartsAt.After(old.EndsAt)
+	}
+	return false
+}
+
+// resolvedOnReceipt returns true if the alert was already resolved when it was
+// received. Alerts that were firing on receipt and whose end time has passed
+// since are not considered, as the source may simply not have refreshed them
+// in time.
+func resolvedOnReceipt(a *types.Alert) bool {
+	return !a.EndsAt.After(a.UpdatedAt)
+}
+
 // Put adds the given alert to the set.
 func (a *Alerts) Put(alerts ...*types.Alert) error {
 	a.mtx.Lock()
@@ -176,6 +205,9 @@ func (a *Alerts) Put(alerts ...*types.Alert) error {
 		fp := alert.Fingerprint()
 
 		if old, ok := a.alerts[fp]; ok {
+			if stale(alert, old) {
+				continue
+			}
 			// Merge alerts if there is an overlap in activity range.
 			if (alert.EndsAt.After(old.StartsAt) && alert.EndsAt.Before(old.EndsAt)) ||
 				(alert.StartsAt.After(old.StartsAt) && alert.StartsAt.Before(old.EndsAt)) {
@@ -175,6 +175,196 @@ func TestAlertsGC(t *testing.T) {
 	}
 }
 
+func TestAlertsPutStaleResolved(t *testing.T) {
+	dir, err := ioutil.TempDir("", "alerts_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var (
+		now = time.Now()
+		t0  = now.Add(-20 * time.Minute)
+		t1  = now.Add(-10 * time.Minute)
+		t2  = now.Add(-5 * time.Minute)
+	)
+
+	// A resolution of the occurrence between t0 and t1, received after
+	// the alert started firing again at t2.
+	resolved := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"bar": "foo"},
+			StartsAt: t0,
+			EndsAt:   t1,
+		},
+		UpdatedAt: now,
+	}
+	firing := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"bar": "foo"},
+			StartsAt: t2,
+			EndsAt:   now.Add(30 * time.Minute),
+		},
+		UpdatedAt: now.Add(-time.Minute),
+		Timeout:   true,
+	}
+
+	for _, order := range [][]*types.Alert{
+		{resolved, firing},
+		{firing, resolved},
+	} {
+		alerts, err := NewAlerts(types.NewMarker(), 30*time.Minute, dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, a := range order {
+			if err := alerts.Put(a); err != nil {
+				t.Fatalf("Insert failed: %s", err)
+			}
+		}
+
+		res, err := alerts.Get(firing.Fingerprint())
+		if err != nil {
+			t.Fatalf("retrieval error: %s", err)
+		}
+		if !alertsEqual(res, firing) {
+			t.Fatalf("Expected alert to remain firing: %s", pretty.Compare(res, firing))
+		}
+	}
+}
+
+func TestAlertsPutBatchOrder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "alerts_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var (
+		now = time.Now()
+		t0  = now.Add(-20 * time.Minute)
+		t1  = now.Add(-10 * time.Minute)
+		t2  = now.Add(-5 * time.Minute)
+	)
+
+	newAlert := func(start, end time.Time, timeout bool) *types.Alert {
+		return &types.Alert{
+			Alert: model.Alert{
+				Labels:   model.LabelSet{"bar": "foo"},
+				StartsAt: start,
+				EndsAt:   end,
+			},
+			UpdatedAt: now,
+			Timeout:   timeout,
+		}
+	}
+
+	cases := []struct {
+		firing, resolved *types.Alert
+		expected         string
+	}{
+		{
+			// The alert fired again after the resolution.
+			firing:   newAlert(t2, now.Add(30*time.Minute), true),
+			resolved: newAlert(t0, t1, false),
+			expected: "firing",
+		}, {
+			// The resolution ends the firing occurrence.
+			firing:   newAlert(t0, now.Add(30*time.Minute), true),
+			resolved: newAlert(t0, t1, false),
+			expected: "resolved",
+		},
+	}
+
+	for i, c := range cases {
+		for _, batch := range [][]*types.Alert{
+			{c.firing, c.resolved},
+			{c.resolved, c.firing},
+		} {
+			alerts, err := NewAlerts(types.NewMarker(), 30*time.Minute, dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := alerts.Put(batch...); err != nil {
+				t.Fatalf("Insert failed: %s", err)
+			}
+
+			res, err := alerts.Get(c.firing.Fingerprint())
+			if err != nil {
+				t.Fatalf("retrieval error: %s", err)
+			}
+			if string(res.Status()) != c.expected {
+				t.Errorf("case %d: expected alert to be %s, got %s", i, c.expected, res.Status())
+			}
+		}
+	}
+}
+
+func TestAlertsPutRefresh(t *testing.T) {
+	dir, err := ioutil.TempDir("", "alerts_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+
+	newAlert := func(start, end, updated time.Time, timeout bool) *types.Alert {
+		return &types.Alert{
+			Alert: model.Alert{
+				Labels:   model.LabelSet{"bar": "foo"},
+				StartsAt: start,
+				EndsAt:   end,
+			},
+			UpdatedAt: updated,
+			Timeout:   timeout,
+		}
+	}
+
+	cases := []struct {
+		old, refresh *types.Alert
+		expected     string
+	}{
+		{
+			// The end time of a firing alert lapsed before it was refreshed.
+			old:      newAlert(now.Add(-time.Hour), now.Add(-time.Minute), now.Add(-10*time.Minute), false),
+			refresh:  newAlert(now.Add(-time.Hour), now.Add(3*time.Minute), now, false),
+			expected: "firing",
+		}, {
+			// The alert was resolved by timeout and is sent again.
+			old:      newAlert(now.Add(-time.Hour), now.Add(-time.Minute), now.Add(-time.Minute), true),
+			refresh:  newAlert(now.Add(-time.Hour), now.Add(5*time.Minute), now, true),
+			expected: "firing",
+		}, {
+			// The alert was explicitly resolved before a late update arrived.
+			old:      newAlert(now.Add(-time.Hour), now.Add(-time.Minute), now.Add(-time.Minute), false),
+			refresh:  newAlert(now.Add(-time.Hour), now.Add(5*time.Minute), now, true),
+			expected: "resolved",
+		},
+	}
+
+	for i, c := range cases {
+		alerts, err := NewAlerts(types.NewMarker(), 30*time.Minute, dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := alerts.Put(c.old); err != nil {
+			t.Fatalf("Insert failed: %s", err)
+		}
+		if err := alerts.Put(c.refresh); err != nil {
+			t.Fatalf("Insert failed: %s", err)
+		}
+
+		res, err := alerts.Get(c.refresh.Fingerprint())
+		if err != nil {
+			t.Fatalf("retrieval error: %s", err)
+		}
+		if string(res.Status()) != c.expected {
+			t.Errorf("case %d: expected alert to be %s, got %s", i, c.expected, res.Status())
+		}
+	}
+}
+
 func alertsEqual(a1, a2 *types.Alert) bool {
 	if !reflect.DeepEqual(a1.Labels, a2.Labels) {
 		return false
@@ -360,7 +360,14 @@ func (s *Silences) setSilence(sil *pb.Silence) error {
 	if err := validateSilence(sil); err != nil {
 		return errors.Wrap(err, "silence invalid")
 	}
+	s.storeSilence(sil)
 
+	return nil
+}
+
+// storeSilence merges an already validated silence into the state and
+// gossips it to peers.
+func (s *Silences) storeSilence(sil *pb.Silence) {
 	msil := &pb.MeshSilence{
 		Silence:   sil,
 		ExpiresAt: sil.EndsAt.Add(s.retention),
@@ -369,8 +376,6 @@ func (s *Silences) setSilence(sil *pb.Silence) error {
 
 	s.st.Merge(st)
 	s.gossip.GossipBroadcast(st)
-
-	return nil
 }
 
 // Set the specified silence. If a silence with the ID already exists and the modification
@@ -463,6 +468,47 @@ func (s *Silences) expire(id string) error {
 	return s.setSilence(sil)
 }
 
+// Export returns shallow copies of all silences in the state, including expired
+// ones that have not been garbage collected yet.
+func (s *Silences) Export() []*pb.Silence {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	res := make([]*pb.Silence, 0, len(s.st))
+	for _, msil := range s.st {
+		res = append(res, cloneSilence(msil.Silence))
+	}
+	return res
+}
+
+// Import merges the given silences, e.g. previously returned by Export, into
+// the state. Unlike Set, the IDs of the silences are kept and an existing
+// silence with the same ID is replaced rather than expired. The update
+// timestamp is set to the time of the import so that the imported silences
+// take precedence when gossiped to peers. If any silence is invalid, none
+// are imported.
+func (s *Silences) Import(sils ...*pb.Silence) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	now := s.now()
+	imports := make([]*pb.Silence, 0, len(sils))
+
+	for _, sil := range sils {
+		sil = cloneSilence(sil)
+		sil.UpdatedAt = now
+
+		if err := validateSilence(sil); err != nil {
+			return errors.Wrapf(err, "silence %q invalid", sil.Id)
+		}
+		imports = append(imports, sil)
+	}
+	for _, sil := range imports {
+		s.storeSilence(sil)
+	}
+	return nil
+}
+
 // QueryParam expresses parameters along which silences are queried.
 type QueryParam func(*query) error
 
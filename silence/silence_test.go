@@ -783,6 +783,115 @@ func TestSilenceExpire(t *testing.T) {
 	}, sil)
 }
 
+func TestSilencesExportImport(t *testing.T) {
+	s1, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+
+	now := time.Now()
+	s1.now = func() time.Time { return now }
+
+	m := &pb.Matcher{Type: pb.Matcher_EQUAL, Name: "a", Pattern: "b"}
+
+	s1.st = gossipData{
+		"active": &pb.MeshSilence{Silence: &pb.Silence{
+			Id:        "active",
+			Matchers:  []*pb.Matcher{m},
+			StartsAt:  now.Add(-time.Minute),
+			EndsAt:    now.Add(time.Hour),
+			UpdatedAt: now.Add(-time.Hour),
+			CreatedBy: "me",
+			Comment:   "active",
+		}},
+		"pending": &pb.MeshSilence{Silence: &pb.Silence{
+			Id:        "pending",
+			Matchers:  []*pb.Matcher{m},
+			StartsAt:  now.Add(time.Minute),
+			EndsAt:    now.Add(time.Hour),
+			UpdatedAt: now.Add(-time.Hour),
+		}},
+	}
+
+	exported := s1.Export()
+	require.Len(t, exported, 2)
+
+	// Importing into an empty instance keeps the IDs.
+	s2, err := New(Options{Retention: time.Hour})
+	require.NoError(t, err)
+	s2.now = func() time.Time { return now.Add(time.Second) }
+
+	require.NoError(t, s2.Import(exported...))
+
+	sils, err := s2.Query()
+	require.NoError(t, err)
+	require.Len(t, sils, 2)
+
+	for _, id := range []string{"active", "pending"} {
+		sil, err := s2.QueryOne(QIDs(id))
+		require.NoError(t, err)
+
+		want := cloneSilence(s1.st[id].Silence)
+		want.UpdatedAt = now.Add(time.Second)
+		require.Equal(t, want, sil)
+		require.Equal(t, want.EndsAt.Add(time.Hour), s2.st[id].ExpiresAt)
+	}
+
+	// Importing over expired silences restores them in place.
+	require.NoError(t, s1.Expire("active"))
+	require.NoError(t, s1.Expire("pending"))
+
+	s1.now = func() time.Time { return now.Add(time.Second) }
+	require.NoError(t, s1.Import(exported...))
+
+	sils, err = s1.Query(QState(StateActive))
+	require.NoError(t, err)
+	require.Len(t, sils, 1)
+	require.Equal(t, "active", sils[0].Id)
+
+	sils, err = s1.Query(QState(StatePending))
+	require.NoError(t, err)
+	require.Len(t, sils, 1)
+	require.Equal(t, "pending", sils[0].Id)
+
+	sils, err = s1.Query()
+	require.NoError(t, err)
+	require.Len(t, sils, 2)
+
+	// Exported silences are copies.
+	exported[0].Comment = "changed"
+	for _, sil := range s1.Export() {
+		require.NotEqual(t, "changed", sil.Comment)
+	}
+}
+
+func TestSilencesImportInvalid(t *testing.T) {
+	s, err := New(Options{})
+	require.NoError(t, err)
+
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	m := &pb.Matcher{Type: pb.Matcher_EQUAL, Name: "a", Pattern: "b"}
+
+	err = s.Import(
+		&pb.Silence{
+			Id:       "valid",
+			Matchers: []*pb.Matcher{m},
+			StartsAt: now,
+			EndsAt:   now.Add(time.Hour),
+		},
+		&pb.Silence{
+			Id:       "invalid",
+			StartsAt: now,
+			EndsAt:   now.Add(time.Hour),
+		},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid")
+
+	// No silence is imported if any of them is invalid.
+	require.Len(t, s.st, 0)
+}
+
 func TestValidateMatcher(t *testing.T) {
 	cases := []struct {
 		m   *pb.Matcher